@@ -0,0 +1,52 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// PrometheusConfig holds the credentials for a Prometheus remote-write target.
+// Either BearerToken or the User/Password pair must be set.
+type PrometheusConfig struct {
+	BearerToken string `json:"bearerToken,omitempty"`
+	User        string `json:"user,omitempty"`
+	Password    string `json:"password,omitempty"`
+}
+
+// OtlpConfig holds the connection details for a generic OpenTelemetry OTLP collector.
+type OtlpConfig struct {
+	Headers map[string]string `json:"headers,omitempty"`
+	Tls     *OtlpTLSConfig    `json:"tls,omitempty"`
+}
+
+// OtlpTLSConfig holds an optional mTLS client certificate used to authenticate against the collector.
+type OtlpTLSConfig struct {
+	Cert string `json:"cert"`
+	Key  string `json:"key"`
+	Ca   string `json:"ca,omitempty"`
+}
+
+// VictoriaMetricsConfig holds the credentials for a VictoriaMetrics endpoint.
+// Either BearerToken or the User/Password pair must be set.
+type VictoriaMetricsConfig struct {
+	BearerToken string `json:"bearerToken,omitempty"`
+	User        string `json:"user,omitempty"`
+	Password    string `json:"password,omitempty"`
+}
+
+// ClusterOverrides lets a MonitoringInstance be scoped to explicit DatabaseCluster names,
+// on top of whatever the Selector matches. Exclude always wins over both Include and Selector.
+type ClusterOverrides struct {
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+}